@@ -44,6 +44,26 @@ func DataSourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"cluster_nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"cluster_parameter_group_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -77,6 +97,10 @@ func DataSourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"default_iam_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"elastic_ip": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -106,6 +130,42 @@ func DataSourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			// No separate "destination_type" attribute: LoggingStatus has a single
+			// LogDestinationType field, so a distinct attribute would just duplicate
+			// log_destination_type below.
+			"logging": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"bucket_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"s3_key_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"log_exports": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"log_destination_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"manual_snapshot_retention_period": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"master_username": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -118,6 +178,38 @@ func DataSourceCluster() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"pending_modified_values": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"encryption_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"master_user_password": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"node_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"number_of_nodes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"publicly_accessible": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"port": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -134,6 +226,34 @@ func DataSourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"snapshot_copy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"retention_period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"grant_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"snapshot_schedule_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_schedule_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags": tftags.TagsSchema(),
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -207,8 +327,13 @@ func dataSourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("cluster_type", "single-node")
 	}
 
+	if err := d.Set("cluster_nodes", flattenClusterNodes(rsc.ClusterNodes)); err != nil {
+		return fmt.Errorf("Error saving Cluster Nodes to state for Redshift Cluster (%s): %w", cluster, err)
+	}
+
 	d.Set("cluster_version", rsc.ClusterVersion)
 	d.Set("database_name", rsc.DBName)
+	d.Set("default_iam_role_arn", rsc.DefaultIamRoleArn)
 
 	if rsc.ElasticIpStatus != nil {
 		d.Set("elastic_ip", rsc.ElasticIpStatus.ElasticIp)
@@ -231,13 +356,32 @@ func dataSourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("kms_key_id", rsc.KmsKeyId)
+	d.Set("manual_snapshot_retention_period", rsc.ManualSnapshotRetentionPeriod)
 	d.Set("master_username", rsc.MasterUsername)
 	d.Set("node_type", rsc.NodeType)
 	d.Set("number_of_nodes", rsc.NumberOfNodes)
+
+	if pendingModifiedValuesExist(rsc.PendingModifiedValues) {
+		if err := d.Set("pending_modified_values", flattenPendingModifiedValues(rsc.PendingModifiedValues)); err != nil {
+			return fmt.Errorf("Error saving Pending Modified Values to state for Redshift Cluster (%s): %w", cluster, err)
+		}
+	} else {
+		d.Set("pending_modified_values", nil)
+	}
+
 	d.Set("port", rsc.Endpoint.Port)
 	d.Set("preferred_maintenance_window", rsc.PreferredMaintenanceWindow)
 	d.Set("publicly_accessible", rsc.PubliclyAccessible)
 
+	if rsc.ClusterSnapshotCopyStatus != nil {
+		if err := d.Set("snapshot_copy", flattenClusterSnapshotCopyStatus(rsc.ClusterSnapshotCopyStatus)); err != nil {
+			return fmt.Errorf("Error saving Snapshot Copy to state for Redshift Cluster (%s): %w", cluster, err)
+		}
+	}
+
+	d.Set("snapshot_schedule_identifier", rsc.SnapshotScheduleIdentifier)
+	d.Set("snapshot_schedule_state", rsc.SnapshotScheduleState)
+
 	if err := d.Set("tags", KeyValueTags(rsc.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
@@ -267,5 +411,74 @@ func dataSourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("s3_key_prefix", loggingStatus.S3KeyPrefix)
 	}
 
+	if loggingStatus != nil {
+		if err := d.Set("logging", flattenLoggingStatus(loggingStatus)); err != nil {
+			return fmt.Errorf("Error saving Logging Status to state for Redshift Cluster (%s): %w", cluster, err)
+		}
+	}
+
 	return nil
 }
+
+func flattenClusterSnapshotCopyStatus(scs *redshift.ClusterSnapshotCopyStatus) []map[string]interface{} {
+	m := map[string]interface{}{
+		"destination_region": aws.StringValue(scs.DestinationRegion),
+		"retention_period":   aws.Int64Value(scs.RetentionPeriod),
+		"grant_name":         aws.StringValue(scs.SnapshotCopyGrantName),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func pendingModifiedValuesExist(pmv *redshift.PendingModifiedValues) bool {
+	if pmv == nil {
+		return false
+	}
+
+	return pmv.ClusterType != nil ||
+		pmv.EncryptionType != nil ||
+		pmv.MasterUserPassword != nil ||
+		pmv.NodeType != nil ||
+		pmv.NumberOfNodes != nil ||
+		pmv.PubliclyAccessible != nil
+}
+
+func flattenPendingModifiedValues(pmv *redshift.PendingModifiedValues) []map[string]interface{} {
+	m := map[string]interface{}{
+		"cluster_type":         aws.StringValue(pmv.ClusterType),
+		"encryption_type":      aws.StringValue(pmv.EncryptionType),
+		"master_user_password": aws.StringValue(pmv.MasterUserPassword) != "",
+		"node_type":            aws.StringValue(pmv.NodeType),
+		"number_of_nodes":      aws.Int64Value(pmv.NumberOfNodes),
+		"publicly_accessible":  aws.BoolValue(pmv.PubliclyAccessible),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenClusterNodes(nodes []*redshift.ClusterNode) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(nodes))
+
+	for _, n := range nodes {
+		m := map[string]interface{}{
+			"node_role":          aws.StringValue(n.NodeRole),
+			"private_ip_address": aws.StringValue(n.PrivateIPAddress),
+			"public_ip_address":  aws.StringValue(n.PublicIPAddress),
+		}
+		out = append(out, m)
+	}
+
+	return out
+}
+
+func flattenLoggingStatus(ls *redshift.LoggingStatus) []map[string]interface{} {
+	m := map[string]interface{}{
+		"enable":               aws.BoolValue(ls.LoggingEnabled),
+		"bucket_name":          aws.StringValue(ls.BucketName),
+		"s3_key_prefix":        aws.StringValue(ls.S3KeyPrefix),
+		"log_exports":          aws.StringValueSlice(ls.LogExports),
+		"log_destination_type": aws.StringValue(ls.LogDestinationType),
+	}
+
+	return []map[string]interface{}{m}
+}
@@ -0,0 +1,167 @@
+package redshift
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceClusters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceClustersRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tag_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tag_values": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cluster_identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceClustersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	input := &redshift.DescribeClustersInput{}
+
+	if v, ok := d.GetOk("tag_keys"); ok {
+		input.TagKeys = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tag_values"); ok {
+		input.TagValues = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	nodeType := d.Get("node_type").(string)
+	clusterVersion := d.Get("cluster_version").(string)
+	vpcID := d.Get("vpc_id").(string)
+
+	var clusters []*redshift.Cluster
+
+	log.Printf("[DEBUG] Reading Redshift Clusters")
+	err := conn.DescribeClustersPages(input, func(page *redshift.DescribeClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, c := range page.Clusters {
+			if c == nil {
+				continue
+			}
+
+			if nodeType != "" && aws.StringValue(c.NodeType) != nodeType {
+				continue
+			}
+
+			if clusterVersion != "" && aws.StringValue(c.ClusterVersion) != clusterVersion {
+				continue
+			}
+
+			if vpcID != "" && aws.StringValue(c.VpcId) != vpcID {
+				continue
+			}
+
+			clusters = append(clusters, c)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error describing Redshift Clusters: %w", err)
+	}
+
+	var arns []string
+	var clusterIdentifiers []string
+	var endpoints []map[string]interface{}
+
+	for _, c := range clusters {
+		arns = append(arns, clusterARN(meta, aws.StringValue(c.ClusterIdentifier)))
+		clusterIdentifiers = append(clusterIdentifiers, aws.StringValue(c.ClusterIdentifier))
+
+		endpoint := map[string]interface{}{}
+		if c.Endpoint != nil {
+			endpoint["address"] = aws.StringValue(c.Endpoint.Address)
+			endpoint["port"] = aws.Int64Value(c.Endpoint.Port)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("Error saving ARNs to state: %w", err)
+	}
+
+	if err := d.Set("cluster_identifiers", clusterIdentifiers); err != nil {
+		return fmt.Errorf("Error saving Cluster Identifiers to state: %w", err)
+	}
+
+	if err := d.Set("endpoints", endpoints); err != nil {
+		return fmt.Errorf("Error saving Endpoints to state: %w", err)
+	}
+
+	return nil
+}
+
+func clusterARN(meta interface{}, clusterIdentifier string) string {
+	client := meta.(*conns.AWSClient)
+
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "redshift",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("cluster:%s", clusterIdentifier),
+	}.String()
+}
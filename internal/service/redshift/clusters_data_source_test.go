@@ -0,0 +1,153 @@
+package redshift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRedshiftClustersDataSource_filterMatch(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_redshift_clusters.test"
+	resourceName := "aws_redshift_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClustersDataSourceConfig_filterMatch(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_identifiers.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "cluster_identifiers.*", resourceName, "cluster_identifier"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoints.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftClustersDataSource_filterExclude(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_redshift_clusters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClustersDataSourceConfig_filterExclude(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_identifiers.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftClustersDataSource_tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_redshift_clusters.test"
+	resourceName := "aws_redshift_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClustersDataSourceConfig_tags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_identifiers.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "cluster_identifiers.*", resourceName, "cluster_identifier"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClustersDataSourceConfig_filterMatch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier  = %[1]q
+  database_name       = "mydb"
+  master_username     = "foo_test"
+  master_password     = "Mustbe8characters"
+  node_type           = "dc2.large"
+  cluster_type        = "single-node"
+  skip_final_snapshot = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_redshift_clusters" "test" {
+  tag_keys   = ["Name"]
+  tag_values = [%[1]q]
+
+  depends_on = [aws_redshift_cluster.test]
+}
+`, rName)
+}
+
+func testAccClustersDataSourceConfig_filterExclude(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier  = %[1]q
+  database_name       = "mydb"
+  master_username     = "foo_test"
+  master_password     = "Mustbe8characters"
+  node_type           = "dc2.large"
+  cluster_type        = "single-node"
+  skip_final_snapshot = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_redshift_clusters" "test" {
+  tag_keys   = ["Name"]
+  tag_values = ["%[1]s-nonexistent"]
+
+  depends_on = [aws_redshift_cluster.test]
+}
+`, rName)
+}
+
+func testAccClustersDataSourceConfig_tags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier  = %[1]q
+  database_name       = "mydb"
+  master_username     = "foo_test"
+  master_password     = "Mustbe8characters"
+  node_type           = "dc2.large"
+  cluster_type        = "single-node"
+  skip_final_snapshot = true
+
+  tags = {
+    Name        = %[1]q
+    Environment = "acctest"
+  }
+}
+
+data "aws_redshift_clusters" "test" {
+  tag_keys   = ["Name", "Environment"]
+  tag_values = [%[1]q, "acctest"]
+
+  depends_on = [aws_redshift_cluster.test]
+}
+`, rName)
+}